@@ -0,0 +1,258 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/mr-tron/base58"
+)
+
+// MessagePublication mirrors the Wormhole guardian's observation of a single
+// Solana program emitting a cross-chain message: which program emitted it,
+// at what sequence, and what payload it carried.
+type MessagePublication struct {
+	EmitterChain   string `json:"emitterChain"`
+	EmitterAddress string `json:"emitterAddress"`
+	Sequence       uint64 `json:"sequence"`
+	Payload        []byte `json:"payload"`
+}
+
+// SolanaWatcherConfig configures the in-process watcher used to bridge
+// observed Solana state onto a Cosmos chain endpoint for this test.
+type SolanaWatcherConfig struct {
+	EmitterProgramID string
+	Commitment       string // "confirmed" or "finalized"
+	PollInterval     time.Duration
+	BackfillSlots    uint64
+}
+
+// solanaWatcher polls Solana for blocks at cfg.Commitment and republishes any
+// transaction touching EmitterProgramID as a MessagePublication against a
+// Cosmos chain endpoint, modelled on Wormhole's SolanaWatcher.
+type solanaWatcher struct {
+	s        *TestSuite
+	cfg      SolanaWatcherConfig
+	lastSlot uint64
+
+	mu        sync.Mutex
+	published []MessagePublication
+}
+
+func newSolanaWatcher(s *TestSuite, cfg SolanaWatcherConfig) *solanaWatcher {
+	return &solanaWatcher{s: s, cfg: cfg}
+}
+
+// run polls on cfg.PollInterval until ctx is cancelled, publishing any
+// messages it observes to the Cosmos chain.
+func (w *solanaWatcher) run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.pollOnce()
+		}
+	}
+}
+
+// pollOnce fetches the current slot at cfg.Commitment and scans any new blocks since
+// lastSlot, bounded by BackfillSlots so a watcher reconnecting after a gap
+// doesn't replay the entire chain history.
+func (w *solanaWatcher) pollOnce() {
+	var slotResponse SolanaSlotResponse
+	w.s.MakeSolanaRPCRequest("getSlot", []interface{}{}, &slotResponse, w.cfg.Commitment)
+	if slotResponse.Error != nil {
+		return
+	}
+
+	currentSlot := slotResponse.Result
+	startSlot := w.lastSlot + 1
+	if w.lastSlot == 0 {
+		startSlot = currentSlot
+	} else if currentSlot-w.lastSlot > w.cfg.BackfillSlots {
+		startSlot = currentSlot - w.cfg.BackfillSlots
+	}
+
+	for slot := startSlot; slot <= currentSlot; slot++ {
+		w.scanBlock(slot)
+	}
+
+	w.lastSlot = currentSlot
+}
+
+// scanBlock fetches a single block and publishes a MessagePublication for
+// every instruction of each transaction whose account keys include the
+// configured emitter.
+func (w *solanaWatcher) scanBlock(slot uint64) {
+	var response SolanaBlockResponse
+	w.s.MakeSolanaRPCRequest("getBlock", []interface{}{
+		slot,
+		map[string]interface{}{
+			"encoding":                       "json",
+			"transactionDetails":             "full",
+			"maxSupportedTransactionVersion": 0,
+			"commitment":                     w.cfg.Commitment,
+		},
+	}, &response)
+
+	if isSkippedSlotError(response.Error) || response.Result == nil {
+		return
+	}
+
+	for _, rawTx := range response.Result.Transactions {
+		var tx struct {
+			Transaction struct {
+				Message struct {
+					AccountKeys  []string `json:"accountKeys"`
+					Instructions []struct {
+						ProgramIDIndex int    `json:"programIdIndex"`
+						Data           string `json:"data"`
+					} `json:"instructions"`
+				} `json:"message"`
+			} `json:"transaction"`
+		}
+		txBytes, err := json.Marshal(rawTx)
+		if err != nil {
+			continue
+		}
+		if err := json.Unmarshal(txBytes, &tx); err != nil {
+			continue
+		}
+
+		touchesEmitter := false
+		for _, key := range tx.Transaction.Message.AccountKeys {
+			if key == w.cfg.EmitterProgramID {
+				touchesEmitter = true
+				break
+			}
+		}
+		if !touchesEmitter {
+			continue
+		}
+
+		for _, instruction := range tx.Transaction.Message.Instructions {
+			payload, err := base58.Decode(instruction.Data)
+			if err != nil {
+				continue
+			}
+
+			w.mu.Lock()
+			sequence := uint64(len(w.published))
+			message := MessagePublication{
+				EmitterChain:   "solana",
+				EmitterAddress: w.cfg.EmitterProgramID,
+				Sequence:       sequence,
+				Payload:        payload,
+			}
+			w.published = append(w.published, message)
+			w.mu.Unlock()
+
+			w.publishToCosmos(message)
+		}
+	}
+}
+
+// publishToCosmos forwards an observed message to the Cosmos chain's REST
+// endpoint using the suite's standard HTTP request helper.
+func (w *solanaWatcher) publishToCosmos(message MessagePublication) {
+	var cosmosChain *Chain
+	for _, chain := range w.s.config.Chains {
+		if chain.Name == "cosmoshub" || chain.Name == "gaia" || chain.Name == "osmosis" {
+			cosmosChain = chain
+			break
+		}
+	}
+	if cosmosChain == nil {
+		return
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return
+	}
+
+	url := fmt.Sprintf("http://0.0.0.0:%d/wormhole/observations", cosmosChain.Ports.Rest)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	w.s.MakeRequest(req, 200)
+}
+
+// observedCount returns how many messages the watcher has published so far.
+func (w *solanaWatcher) observedCount() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.published)
+}
+
+func (s *TestSuite) TestSolana_CrossChain_WormholeStyleWatcher() {
+	s.T().Log("running test for Solana -> Cosmos cross-chain observation")
+
+	var solanaChain, cosmosChain *Chain
+	for _, chain := range s.config.Chains {
+		switch chain.Name {
+		case "solana":
+			solanaChain = chain
+		case "cosmoshub", "gaia", "osmosis":
+			cosmosChain = chain
+		}
+	}
+	if solanaChain == nil || cosmosChain == nil {
+		s.T().Skip("test requires both a Solana chain and a Cosmos chain in config")
+	}
+
+	emitter := NewSolanaKeypair(s)
+	s.RequestAirdrop(emitter.PublicKey, 2_000_000_000)
+
+	watcher := newSolanaWatcher(s, SolanaWatcherConfig{
+		EmitterProgramID: emitter.PublicKey.String(),
+		Commitment:       "finalized",
+		PollInterval:     1 * time.Second,
+		BackfillSlots:    150,
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		watcher.run(ctx)
+	}()
+
+	const wantMessages = 1
+	const deadline = 30 * time.Second
+
+	destination := NewSolanaKeypair(s)
+	blockhash := s.GetLatestBlockhash()
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1_000_000, emitter.PublicKey, destination.PublicKey).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(emitter.PublicKey),
+	)
+	s.Require().NoError(err)
+	s.SendAndConfirmTx(tx, "finalized", emitter)
+
+	s.Require().Eventually(func() bool {
+		return watcher.observedCount() >= wantMessages
+	}, deadline, 500*time.Millisecond, "watcher should observe at least %d message(s) from the emitter", wantMessages)
+
+	cancel()
+	wg.Wait()
+}
@@ -0,0 +1,258 @@
+package e2e
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"nhooyr.io/websocket"
+	"nhooyr.io/websocket/wsjson"
+)
+
+// SolanaSubscribeResponse represents the response to a *Subscribe RPC call,
+// carrying the subscription id used to correlate later notifications.
+type SolanaSubscribeResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  int    `json:"result"`
+}
+
+// SolanaNotification represents a single `<method>Notification` envelope
+// delivered over the PubSub websocket for a given subscription.
+type SolanaNotification struct {
+	JSONRPC string `json:"jsonrpc"`
+	Method  string `json:"method"`
+	Params  struct {
+		Subscription int             `json:"subscription"`
+		Result       json.RawMessage `json:"result"`
+	} `json:"params"`
+}
+
+// SolanaPubsubClient manages a single PubSub websocket connection and the
+// subscriptions created against it.
+type SolanaPubsubClient struct {
+	s    *TestSuite
+	conn *websocket.Conn
+}
+
+// DialSolanaPubsub opens the Solana PubSub websocket for the configured
+// solana chain, skipping the test if the chain isn't present.
+func (s *TestSuite) DialSolanaPubsub() *SolanaPubsubClient {
+	var solanaChain *Chain
+	for _, chain := range s.config.Chains {
+		if chain.Name == "solana" {
+			solanaChain = chain
+			break
+		}
+	}
+
+	if solanaChain == nil {
+		s.T().Skip("Solana chain not found in config")
+	}
+
+	// The Ports struct in this tree doesn't define a dedicated PubSub/websocket
+	// port for Solana. Follow the solana-test-validator default instead, where
+	// the PubSub websocket listens on rpcPort+1.
+	url := fmt.Sprintf("ws://0.0.0.0:%d", solanaChain.Ports.Rpc+1)
+	conn, _, err := websocket.Dial(context.Background(), url, nil)
+	s.Require().NoError(err)
+
+	return &SolanaPubsubClient{s: s, conn: conn}
+}
+
+// Close terminates the underlying websocket connection.
+func (c *SolanaPubsubClient) Close() {
+	_ = c.conn.Close(websocket.StatusNormalClosure, "test complete")
+}
+
+// subscribe sends a `<method>Subscribe` request and returns the subscription id.
+func (c *SolanaPubsubClient) subscribe(ctx context.Context, method string, params []interface{}) int {
+	request := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	}
+	c.s.Require().NoError(wsjson.Write(ctx, c.conn, request))
+
+	var response SolanaSubscribeResponse
+	c.s.Require().NoError(wsjson.Read(ctx, c.conn, &response))
+
+	return response.Result
+}
+
+// unsubscribe sends the matching `<method>Unsubscribe` request for a subscription.
+func (c *SolanaPubsubClient) unsubscribe(ctx context.Context, method string, subscriptionID int) {
+	request := map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  []interface{}{subscriptionID},
+	}
+	_ = wsjson.Write(ctx, c.conn, request)
+}
+
+// collectNotifications reads the next n notifications off the websocket,
+// stopping early if deadline elapses. Notifications are not filtered by
+// subscription id.
+func (c *SolanaPubsubClient) collectNotifications(n int, deadline time.Duration) []SolanaNotification {
+	ctx, cancel := context.WithTimeout(context.Background(), deadline)
+	defer cancel()
+
+	notifications := make([]SolanaNotification, 0, n)
+	for len(notifications) < n {
+		var notification SolanaNotification
+		if err := wsjson.Read(ctx, c.conn, &notification); err != nil {
+			break
+		}
+		notifications = append(notifications, notification)
+	}
+
+	return notifications
+}
+
+func (s *TestSuite) TestSolana_PubSub_SlotSubscribe() {
+	s.T().Log("running test for Solana slotSubscribe")
+
+	client := s.DialSolanaPubsub()
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	subID := client.subscribe(ctx, "slotSubscribe", []interface{}{})
+	defer client.unsubscribe(context.Background(), "slotUnsubscribe", subID)
+
+	notifications := client.collectNotifications(3, 10*time.Second)
+	s.Require().GreaterOrEqual(len(notifications), 2, "should receive at least two slot notifications")
+
+	var lastSlot uint64
+	for i, notification := range notifications {
+		var result struct {
+			Slot   uint64 `json:"slot"`
+			Parent uint64 `json:"parent"`
+			Root   uint64 `json:"root"`
+		}
+		s.Require().NoError(json.Unmarshal(notification.Params.Result, &result))
+
+		if i > 0 {
+			s.Require().GreaterOrEqual(result.Slot, lastSlot, "slot notifications should be monotonically increasing")
+		}
+		lastSlot = result.Slot
+	}
+}
+
+func (s *TestSuite) TestSolana_PubSub_LogsSubscribe() {
+	s.T().Log("running test for Solana logsSubscribe")
+
+	client := s.DialSolanaPubsub()
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	subID := client.subscribe(ctx, "logsSubscribe", []interface{}{
+		map[string]interface{}{"mentions": []string{"11111111111111111111111111111111"}},
+		map[string]interface{}{"commitment": "confirmed"},
+	})
+	defer client.unsubscribe(context.Background(), "logsUnsubscribe", subID)
+
+	source := NewSolanaKeypair(s)
+	s.RequestAirdrop(source.PublicKey, 1_000_000_000)
+
+	notifications := client.collectNotifications(1, 15*time.Second)
+	s.Require().GreaterOrEqual(len(notifications), 1, "should receive at least one log notification after an airdrop")
+}
+
+func (s *TestSuite) TestSolana_PubSub_AccountSubscribe() {
+	s.T().Log("running test for Solana accountSubscribe")
+
+	client := s.DialSolanaPubsub()
+	defer client.Close()
+
+	account := NewSolanaKeypair(s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	subID := client.subscribe(ctx, "accountSubscribe", []interface{}{
+		account.PublicKey.String(),
+		map[string]interface{}{"commitment": "confirmed", "encoding": "base64"},
+	})
+	defer client.unsubscribe(context.Background(), "accountUnsubscribe", subID)
+
+	s.RequestAirdrop(account.PublicKey, 1_000_000_000)
+
+	notifications := client.collectNotifications(1, 15*time.Second)
+	s.Require().GreaterOrEqual(len(notifications), 1, "should receive an account notification after funding")
+}
+
+func (s *TestSuite) TestSolana_PubSub_SignatureSubscribe() {
+	s.T().Log("running test for Solana signatureSubscribe")
+
+	source := NewSolanaKeypair(s)
+	destination := NewSolanaKeypair(s)
+	s.RequestAirdrop(source.PublicKey, 2_000_000_000)
+
+	client := s.DialSolanaPubsub()
+	defer client.Close()
+
+	blockhash := s.GetLatestBlockhash()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(1_000_000, source.PublicKey, destination.PublicKey).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(source.PublicKey),
+	)
+	s.Require().NoError(err)
+
+	_, err = tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		if source.PublicKey.Equals(key) {
+			return &source.PrivateKey
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	rawTx, err := tx.MarshalBinary()
+	s.Require().NoError(err)
+
+	// Subscribe on the locally-computed signature before sending, so there's
+	// no race where the tx confirms before the subscription exists and the
+	// notification is never delivered.
+	signature := tx.Signatures[0].String()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	subID := client.subscribe(ctx, "signatureSubscribe", []interface{}{
+		signature,
+		map[string]interface{}{"commitment": "confirmed"},
+	})
+	defer client.unsubscribe(context.Background(), "signatureUnsubscribe", subID)
+
+	var response SolanaSignatureResponse
+	s.MakeSolanaRPCRequest("sendTransaction", []interface{}{
+		base64.StdEncoding.EncodeToString(rawTx),
+		map[string]interface{}{"encoding": "base64"},
+	}, &response)
+	s.Require().Nil(response.Error, "RPC should not return error")
+	s.Require().Equal(signature, response.Result, "sendTransaction should return the precomputed signature")
+
+	notifications := client.collectNotifications(1, 15*time.Second)
+	s.Require().Len(notifications, 1, "should receive exactly one signature notification")
+
+	var result struct {
+		Value struct {
+			Err interface{} `json:"err"`
+		} `json:"value"`
+	}
+	s.Require().NoError(json.Unmarshal(notifications[0].Params.Result, &result))
+	s.Require().Nil(result.Value.Err, "subscribed transaction should confirm without error")
+}
@@ -0,0 +1,183 @@
+package e2e
+
+import (
+	"strconv"
+
+	"github.com/gagliardetto/solana-go"
+	associatedtokenaccount "github.com/gagliardetto/solana-go/programs/associated-token-account"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+)
+
+const splTokenDecimals = 6
+
+// SolanaTokenBalanceResponse represents the getTokenAccountBalance response
+type SolanaTokenBalanceResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  struct {
+		Context struct {
+			Slot uint64 `json:"slot"`
+		} `json:"context"`
+		Value struct {
+			Amount         string  `json:"amount"`
+			Decimals       int     `json:"decimals"`
+			UiAmount       float64 `json:"uiAmount"`
+			UiAmountString string  `json:"uiAmountString"`
+		} `json:"value"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// SolanaTokenSupplyResponse represents the getTokenSupply response
+type SolanaTokenSupplyResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  struct {
+		Context struct {
+			Slot uint64 `json:"slot"`
+		} `json:"context"`
+		Value struct {
+			Amount         string  `json:"amount"`
+			Decimals       int     `json:"decimals"`
+			UiAmount       float64 `json:"uiAmount"`
+			UiAmountString string  `json:"uiAmountString"`
+		} `json:"value"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// createMint funds a new mint authority, creates the mint account, and
+// initializes it with the given number of decimals, returning the mint
+// keypair and its authority.
+func (s *TestSuite) createMint(decimals uint8) (mint SolanaKeypair, authority SolanaKeypair) {
+	authority = NewSolanaKeypair(s)
+	mint = NewSolanaKeypair(s)
+
+	s.RequestAirdrop(authority.PublicKey, 2_000_000_000)
+
+	const mintAccountSpace = 82 // token.MintAccountSize
+
+	var rentResponse SolanaRPCResponse
+	s.MakeSolanaRPCRequest("getMinimumBalanceForRentExemption", []interface{}{mintAccountSpace}, &rentResponse)
+	s.Require().Nil(rentResponse.Error, "RPC should not return error")
+	rentLamports := uint64(rentResponse.Result.(float64))
+
+	blockhash := s.GetLatestBlockhash()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewCreateAccountInstruction(
+				rentLamports,
+				mintAccountSpace,
+				solana.TokenProgramID,
+				authority.PublicKey,
+				mint.PublicKey,
+			).Build(),
+			token.NewInitializeMintInstruction(
+				decimals,
+				authority.PublicKey,
+				authority.PublicKey,
+				mint.PublicKey,
+				solana.SysVarRentPubkey,
+			).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(authority.PublicKey),
+	)
+	s.Require().NoError(err)
+
+	s.SendAndConfirmTx(tx, "confirmed", authority, mint)
+
+	return mint, authority
+}
+
+// createAssociatedTokenAccount creates (and funds the creation of) the ATA
+// for owner's balance of mint, returning its address.
+func (s *TestSuite) createAssociatedTokenAccount(payer SolanaKeypair, mint solana.PublicKey, owner solana.PublicKey) solana.PublicKey {
+	ata, _, err := solana.FindAssociatedTokenAddress(owner, mint)
+	s.Require().NoError(err)
+
+	blockhash := s.GetLatestBlockhash()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			associatedtokenaccount.NewCreateInstruction(payer.PublicKey, owner, mint).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(payer.PublicKey),
+	)
+	s.Require().NoError(err)
+
+	s.SendAndConfirmTx(tx, "confirmed", payer)
+
+	return ata
+}
+
+func (s *TestSuite) TestSolana_SPLToken_MintAndTransfer() {
+	s.T().Log("running test for Solana SPL token mint-and-transfer lifecycle")
+
+	const mintAmount = 1_000_000
+	const transferAmount = mintAmount / 2
+
+	mint, authority := s.createMint(splTokenDecimals)
+	s.T().Logf("created mint %s with authority %s", mint.PublicKey, authority.PublicKey)
+
+	recipient := NewSolanaKeypair(s)
+	secondRecipient := NewSolanaKeypair(s)
+	s.RequestAirdrop(recipient.PublicKey, 1_000_000_000)
+
+	recipientATA := s.createAssociatedTokenAccount(authority, mint.PublicKey, recipient.PublicKey)
+	secondATA := s.createAssociatedTokenAccount(authority, mint.PublicKey, secondRecipient.PublicKey)
+
+	blockhash := s.GetLatestBlockhash()
+	mintTx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			token.NewMintToInstruction(mintAmount, mint.PublicKey, recipientATA, authority.PublicKey, nil).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(authority.PublicKey),
+	)
+	s.Require().NoError(err)
+	s.SendAndConfirmTx(mintTx, "confirmed", authority)
+
+	var supplyAfterMint SolanaTokenSupplyResponse
+	s.MakeSolanaRPCRequest("getTokenSupply", []interface{}{mint.PublicKey.String()}, &supplyAfterMint)
+	s.Require().Nil(supplyAfterMint.Error, "RPC should not return error")
+	s.Require().Equal(int64(mintAmount), mustParseTokenAmount(s, supplyAfterMint.Result.Value.Amount))
+
+	transferBlockhash := s.GetLatestBlockhash()
+	transferTx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			token.NewTransferInstruction(transferAmount, recipientATA, secondATA, recipient.PublicKey, nil).Build(),
+		},
+		transferBlockhash,
+		solana.TransactionPayer(recipient.PublicKey),
+	)
+	s.Require().NoError(err)
+	s.SendAndConfirmTx(transferTx, "confirmed", recipient)
+
+	var recipientBalance, secondBalance SolanaTokenBalanceResponse
+	s.MakeSolanaRPCRequest("getTokenAccountBalance", []interface{}{recipientATA.String()}, &recipientBalance)
+	s.Require().Nil(recipientBalance.Error, "RPC should not return error")
+	s.MakeSolanaRPCRequest("getTokenAccountBalance", []interface{}{secondATA.String()}, &secondBalance)
+	s.Require().Nil(secondBalance.Error, "RPC should not return error")
+
+	s.Require().Equal(int64(mintAmount-transferAmount), mustParseTokenAmount(s, recipientBalance.Result.Value.Amount))
+	s.Require().Equal(int64(transferAmount), mustParseTokenAmount(s, secondBalance.Result.Value.Amount))
+	s.Require().Equal(splTokenDecimals, recipientBalance.Result.Value.Decimals)
+}
+
+// mustParseTokenAmount parses a raw decimal token amount string as returned
+// by the SPL token RPC methods, failing the calling test on malformed input.
+func mustParseTokenAmount(s *TestSuite, amount string) int64 {
+	value, err := strconv.ParseInt(amount, 10, 64)
+	s.Require().NoError(err)
+	return value
+}
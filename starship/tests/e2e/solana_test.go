@@ -2,6 +2,7 @@ package e2e
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,6 +12,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -97,7 +100,152 @@ type SolanaExposerResponse struct {
 	NodeID string `json:"node_id"`
 }
 
-func (s *TestSuite) MakeSolanaRPCRequest(method string, params []interface{}, response interface{}) {
+// SolanaBlockhashResponse represents the getLatestBlockhash response
+type SolanaBlockhashResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  struct {
+		Context struct {
+			Slot uint64 `json:"slot"`
+		} `json:"context"`
+		Value struct {
+			Blockhash            string `json:"blockhash"`
+			LastValidBlockHeight uint64 `json:"lastValidBlockHeight"`
+		} `json:"value"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// SolanaSignatureStatusesResponse represents the getSignatureStatuses response
+type SolanaSignatureStatusesResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  struct {
+		Context struct {
+			Slot uint64 `json:"slot"`
+		} `json:"context"`
+		Value []*struct {
+			Slot               uint64 `json:"slot"`
+			Confirmations      *int   `json:"confirmations"`
+			Err                interface{} `json:"err"`
+			ConfirmationStatus string `json:"confirmationStatus"`
+		} `json:"value"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// SolanaKeypair is a thin wrapper around a solana-go private key, generated
+// fresh for each test that needs a funded or recipient account.
+type SolanaKeypair struct {
+	PrivateKey solana.PrivateKey
+	PublicKey  solana.PublicKey
+}
+
+// NewSolanaKeypair generates a new random ed25519 keypair for use in e2e tests.
+func NewSolanaKeypair(s *TestSuite) SolanaKeypair {
+	priv, err := solana.NewRandomPrivateKey()
+	s.Require().NoError(err)
+
+	return SolanaKeypair{
+		PrivateKey: priv,
+		PublicKey:  priv.PublicKey(),
+	}
+}
+
+// RequestAirdrop funds the given address with lamports via the RPC faucet and
+// waits for the resulting transaction to confirm.
+func (s *TestSuite) RequestAirdrop(address solana.PublicKey, lamports uint64) {
+	var airdropResponse SolanaSignatureResponse
+	s.MakeSolanaRPCRequest("requestAirdrop", []interface{}{address.String(), lamports}, &airdropResponse)
+	s.Require().Nil(airdropResponse.Error, "RPC should not return error")
+	s.Require().NotEmpty(airdropResponse.Result, "Should return a transaction signature")
+
+	s.WaitForSignatureConfirmation(airdropResponse.Result, "confirmed", 30*time.Second)
+}
+
+// GetLatestBlockhash fetches a recent blockhash for use in transaction construction.
+func (s *TestSuite) GetLatestBlockhash() solana.Hash {
+	var response SolanaBlockhashResponse
+	s.MakeSolanaRPCRequest("getLatestBlockhash", []interface{}{}, &response)
+	s.Require().Nil(response.Error, "RPC should not return error")
+
+	hash, err := solana.HashFromBase58(response.Result.Value.Blockhash)
+	s.Require().NoError(err)
+
+	return hash
+}
+
+// WaitForSignatureConfirmation polls getSignatureStatuses until the transaction
+// reaches at least the requested commitment ("confirmed" or "finalized"), or
+// fails the test once the timeout elapses.
+func (s *TestSuite) WaitForSignatureConfirmation(signature string, commitment string, timeout time.Duration) {
+	deadline := time.Now().Add(timeout)
+
+	for time.Now().Before(deadline) {
+		var response SolanaSignatureStatusesResponse
+		s.MakeSolanaRPCRequest("getSignatureStatuses", []interface{}{
+			[]string{signature},
+			map[string]interface{}{"searchTransactionHistory": true},
+		}, &response)
+		s.Require().Nil(response.Error, "RPC should not return error")
+
+		if len(response.Result.Value) > 0 && response.Result.Value[0] != nil {
+			status := response.Result.Value[0]
+			s.Require().Nil(status.Err, "transaction %s should not fail", signature)
+
+			if status.ConfirmationStatus == commitment ||
+				(commitment == "confirmed" && status.ConfirmationStatus == "finalized") {
+				return
+			}
+		}
+
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	s.T().Fatalf("transaction %s did not reach commitment %q within %s", signature, commitment, timeout)
+}
+
+// SendAndConfirmTx signs tx with signers, submits it via sendTransaction, and
+// blocks until it reaches the requested commitment. It returns the transaction
+// signature so callers can inspect it further if needed.
+func (s *TestSuite) SendAndConfirmTx(tx *solana.Transaction, commitment string, signers ...SolanaKeypair) string {
+	_, err := tx.Sign(func(key solana.PublicKey) *solana.PrivateKey {
+		for _, signer := range signers {
+			if signer.PublicKey.Equals(key) {
+				return &signer.PrivateKey
+			}
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	rawTx, err := tx.MarshalBinary()
+	s.Require().NoError(err)
+
+	var response SolanaSignatureResponse
+	s.MakeSolanaRPCRequest("sendTransaction", []interface{}{
+		base64.StdEncoding.EncodeToString(rawTx),
+		map[string]interface{}{"encoding": "base64"},
+	}, &response)
+	s.Require().Nil(response.Error, "RPC should not return error")
+	s.Require().NotEmpty(response.Result, "Should return a transaction signature")
+
+	s.WaitForSignatureConfirmation(response.Result, commitment, 30*time.Second)
+
+	return response.Result
+}
+
+// MakeSolanaRPCRequest issues a JSON-RPC call against the Solana chain's RPC
+// port. An optional commitment ("processed", "confirmed", "finalized") may be
+// passed; when present it is appended to params as a `{"commitment": ...}`
+// config object, matching how the Solana RPC expects it on read methods.
+func (s *TestSuite) MakeSolanaRPCRequest(method string, params []interface{}, response interface{}, commitment ...string) {
 	// Get the Solana chain from config
 	var solanaChain *Chain
 	for _, chain := range s.config.Chains {
@@ -106,11 +254,15 @@ func (s *TestSuite) MakeSolanaRPCRequest(method string, params []interface{}, re
 			break
 		}
 	}
-	
+
 	if solanaChain == nil {
 		s.T().Skip("Solana chain not found in config")
 	}
 
+	if len(commitment) > 0 {
+		params = append(params, map[string]interface{}{"commitment": commitment[0]})
+	}
+
 	// Prepare RPC request
 	rpcRequest := map[string]interface{}{
 		"jsonrpc": "2.0",
@@ -156,6 +308,14 @@ func (s *TestSuite) MakeSolanaExposerRequest(endpoint string, response interface
 	s.Require().NoError(err)
 }
 
+// NOTE(vastonus/starship#chunk0-6): a `/metrics` Prometheus endpoint for the
+// Solana sidecar was requested (solana_current_slot, solana_rpc_errors_total,
+// solana_observations_confirmed_total, solana_account_updates_skipped_total),
+// but collecting those requires changes to the exposer sidecar itself, whose
+// source isn't part of this tree. Filed as a follow-up rather than landed
+// half-done here; add MakeSolanaMetricsRequest + TestSolana_Metrics once the
+// sidecar actually emits these series.
+
 func (s *TestSuite) TestSolana_Status() {
 	s.T().Log("running test for Solana RPC status")
 
@@ -272,15 +432,48 @@ func (s *TestSuite) TestSolana_Faucet() {
 func (s *TestSuite) TestSolana_BankTransfer() {
 	s.T().Log("running test for Solana bank transfer")
 
-	// This test would require creating keypairs and performing actual transfers
-	// For now, we'll test the transfer instruction creation
-	// In a real implementation, you'd need to:
-	// 1. Create source and destination keypairs
-	// 2. Fund the source account
-	// 3. Create and send transfer transaction
-	// 4. Verify the transfer
+	const (
+		fundingLamports  = 2_000_000_000 // 2 SOL
+		transferLamports = 1_000_000_000 // 1 SOL
+	)
+
+	source := NewSolanaKeypair(s)
+	destination := NewSolanaKeypair(s)
+
+	s.T().Logf("source: %s, destination: %s", source.PublicKey, destination.PublicKey)
+
+	s.RequestAirdrop(source.PublicKey, fundingLamports)
 
-	s.T().Skip("Bank transfer test requires keypair generation and transaction signing - implement based on your needs")
+	var sourceBefore, destBefore SolanaBalanceResponse
+	s.MakeSolanaRPCRequest("getBalance", []interface{}{source.PublicKey.String()}, &sourceBefore)
+	s.Require().Nil(sourceBefore.Error, "RPC should not return error")
+	s.MakeSolanaRPCRequest("getBalance", []interface{}{destination.PublicKey.String()}, &destBefore)
+	s.Require().Nil(destBefore.Error, "RPC should not return error")
+
+	blockhash := s.GetLatestBlockhash()
+
+	tx, err := solana.NewTransaction(
+		[]solana.Instruction{
+			system.NewTransferInstruction(transferLamports, source.PublicKey, destination.PublicKey).Build(),
+		},
+		blockhash,
+		solana.TransactionPayer(source.PublicKey),
+	)
+	s.Require().NoError(err)
+
+	signature := s.SendAndConfirmTx(tx, "confirmed", source)
+	s.T().Logf("bank transfer transaction signature: %s", signature)
+
+	var sourceAfter, destAfter SolanaBalanceResponse
+	s.MakeSolanaRPCRequest("getBalance", []interface{}{source.PublicKey.String()}, &sourceAfter)
+	s.Require().Nil(sourceAfter.Error, "RPC should not return error")
+	s.MakeSolanaRPCRequest("getBalance", []interface{}{destination.PublicKey.String()}, &destAfter)
+	s.Require().Nil(destAfter.Error, "RPC should not return error")
+
+	s.Require().Equal(destBefore.Result.Value+transferLamports, destAfter.Result.Value,
+		"destination balance should increase by the transferred amount")
+	s.Require().LessOrEqual(sourceAfter.Result.Value, sourceBefore.Result.Value-transferLamports,
+		"source balance should decrease by at least the transferred amount")
 }
 
 func (s *TestSuite) TestSolana_Exposer_NodeID() {
@@ -420,4 +613,114 @@ func (s *TestSuite) TestSolana_NetworkHealth() {
 			s.Require().NotNil(response.Result, fmt.Sprintf("%s should return result", test.name))
 		})
 	}
-} 
\ No newline at end of file
+}
+
+// SolanaBlockResponse represents the getBlock response
+type SolanaBlockResponse struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int    `json:"id"`
+	Result  *struct {
+		BlockHeight       uint64        `json:"blockHeight"`
+		BlockTime         *int64        `json:"blockTime"`
+		Blockhash         string        `json:"blockhash"`
+		ParentSlot        uint64        `json:"parentSlot"`
+		PreviousBlockhash string        `json:"previousBlockhash"`
+		Transactions      []interface{} `json:"transactions"`
+	} `json:"result"`
+	Error *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// isSkippedSlotError reports whether an RPC error represents a skipped slot,
+// which getBlock returns for -32007 ("Slot was skipped") and -32009 ("Slot X
+// was skipped, or missing in long-term storage").
+func isSkippedSlotError(err *struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}) bool {
+	return err != nil && (err.Code == -32007 || err.Code == -32009)
+}
+
+// scanBlocks walks `count` slots forward from `startSlot` at the given
+// commitment, skipping slots the validator reports as skipped, and returns
+// the non-skipped blocks in slot order along with the total transaction count
+// observed across them.
+func (s *TestSuite) scanBlocks(startSlot uint64, count int, commitment string) (blocks []SolanaBlockResponse, txCount int) {
+	for slot := startSlot; slot < startSlot+uint64(count); slot++ {
+		var response SolanaBlockResponse
+		s.MakeSolanaRPCRequest("getBlock", []interface{}{
+			slot,
+			map[string]interface{}{
+				"encoding":                       "json",
+				"transactionDetails":             "full",
+				"maxSupportedTransactionVersion": 0,
+				"commitment":                     commitment,
+			},
+		}, &response)
+
+		if isSkippedSlotError(response.Error) {
+			s.T().Logf("slot %d was skipped, continuing", slot)
+			continue
+		}
+
+		s.Require().Nil(response.Error, "getBlock should not return error for slot %d", slot)
+		s.Require().NotNil(response.Result, "getBlock should return a result for slot %d", slot)
+
+		blocks = append(blocks, response)
+		txCount += len(response.Result.Transactions)
+	}
+
+	return blocks, txCount
+}
+
+func (s *TestSuite) TestSolana_BlockScanner() {
+	s.T().Log("running test for Solana block-by-block scanner")
+
+	var slotResponse SolanaSlotResponse
+	s.MakeSolanaRPCRequest("getSlot", []interface{}{}, &slotResponse, "finalized")
+	s.Require().Nil(slotResponse.Error, "RPC should not return error")
+
+	startSlot := slotResponse.Result
+	if startSlot > 50 {
+		startSlot -= 50
+	}
+
+	blocks, txCount := s.scanBlocks(startSlot, 50, "finalized")
+	s.Require().NotEmpty(blocks, "should observe at least one non-skipped block")
+
+	var previousBlockhash string
+	for i, block := range blocks {
+		result := block.Result
+		s.Require().NotEmpty(result.Blockhash, "block should have a blockhash")
+		s.Require().NotEmpty(result.PreviousBlockhash, "block should have a previousBlockhash")
+		s.Require().NotNil(result.BlockTime, "block should have a blockTime")
+
+		if i > 0 {
+			s.Require().Equal(previousBlockhash, result.PreviousBlockhash,
+				"previousBlockhash should chain to the prior non-skipped block")
+		}
+		previousBlockhash = result.Blockhash
+	}
+
+	s.T().Logf("scanned %d blocks, %d transactions total", len(blocks), txCount)
+	s.Require().Greater(txCount, 0, "cumulative transaction count should be positive once workloads are running")
+}
+
+func (s *TestSuite) TestSolana_BlockScanner_CommitmentLagOrdering() {
+	s.T().Log("running test for Solana commitment lag ordering")
+
+	var processed, confirmed, finalized SolanaSlotResponse
+	s.MakeSolanaRPCRequest("getSlot", []interface{}{}, &processed, "processed")
+	s.Require().Nil(processed.Error, "RPC should not return error")
+	s.MakeSolanaRPCRequest("getSlot", []interface{}{}, &confirmed, "confirmed")
+	s.Require().Nil(confirmed.Error, "RPC should not return error")
+	s.MakeSolanaRPCRequest("getSlot", []interface{}{}, &finalized, "finalized")
+	s.Require().Nil(finalized.Error, "RPC should not return error")
+
+	s.T().Logf("processed: %d, confirmed: %d, finalized: %d", processed.Result, confirmed.Result, finalized.Result)
+
+	s.Require().GreaterOrEqual(processed.Result, confirmed.Result, "processed slot should not lag confirmed slot")
+	s.Require().GreaterOrEqual(confirmed.Result, finalized.Result, "confirmed slot should not lag finalized slot")
+}
\ No newline at end of file